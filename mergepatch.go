@@ -0,0 +1,220 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+)
+
+// nilTracker is satisfied by Variable[T] (and types that embed it, such as
+// StringEncoded[T]). MarshalMergePatch uses it to distinguish an omitted
+// field from one explicitly set to null.
+type nilTracker interface {
+	IsNil() bool
+	Omitted() bool
+	json.Marshaler
+}
+
+// MarshalMergePatch encodes v, which must be a struct or a pointer to one,
+// as an RFC 7396 JSON Merge Patch document. For every field whose type
+// implements the tri-state nil/omitted tracking of Variable, a field that
+// is Omitted() is left out of the document entirely, a field that IsNil()
+// is emitted as "field":null, and any other field is marshaled normally.
+// Regular, non-Variable fields are marshaled with encoding/json under the
+// name from their json tag (or the Go field name when the tag gives none),
+// and a field tagged omitempty is left out when it holds the zero value of
+// its type. Embedded structs are not flattened into the document, and tag
+// options other than omitempty, such as string, are ignored.
+func MarshalMergePatch(v any) ([]byte, error) {
+	rv := reflect.ValueOf(v)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return json.Marshal(nil)
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return json.Marshal(v)
+	}
+
+	if !rv.CanAddr() {
+		// Variable[T]'s tri-state methods have pointer receivers, so an
+		// addressable copy is required to reach them through reflection
+		// when v was passed by value instead of as a pointer to a struct.
+		addressable := reflect.New(rv.Type()).Elem()
+		addressable.Set(rv)
+		rv = addressable
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	rt := rv.Type()
+	wroteField := false
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name, omitempty, skip := parseJSONTag(field)
+		if skip {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fv := rv.Field(i)
+
+		if tracker, ok := mergePatchTracker(fv); ok {
+			if tracker.Omitted() {
+				continue
+			}
+			data, err := tracker.MarshalJSON()
+			if err != nil {
+				return nil, err
+			}
+			if wroteField {
+				buf.WriteByte(',')
+			}
+			wroteField = true
+			writeJSONKey(&buf, name)
+			buf.Write(data)
+			continue
+		}
+
+		if omitempty && fv.IsZero() {
+			continue
+		}
+		data, err := json.Marshal(fv.Interface())
+		if err != nil {
+			return nil, err
+		}
+		if wroteField {
+			buf.WriteByte(',')
+		}
+		wroteField = true
+		writeJSONKey(&buf, name)
+		buf.Write(data)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// mergePatchTracker returns fv as a nilTracker if its address implements
+// the interface, which is how Variable[T]'s pointer-receiver methods are
+// reached through reflection.
+func mergePatchTracker(fv reflect.Value) (nilTracker, bool) {
+	if !fv.CanAddr() {
+		return nil, false
+	}
+	tracker, ok := fv.Addr().Interface().(nilTracker)
+	return tracker, ok
+}
+
+// parseJSONTag extracts the field name and the omitempty option from a
+// struct field's json tag. name is empty when the tag gives none, in which
+// case callers use the Go field name. skip reports whether the field
+// should be left out entirely,
+// which per encoding/json semantics is true only for the literal tag
+// `json:"-"` — `json:"-,"` names the field "-" and is not skipped.
+func parseJSONTag(field reflect.StructField) (name string, omitempty, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		return "", false, false
+	}
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	for _, opt := range parts[1:] {
+		if opt == "omitempty" {
+			omitempty = true
+		}
+	}
+	return name, omitempty, false
+}
+
+func writeJSONKey(buf *bytes.Buffer, name string) {
+	key, _ := json.Marshal(name)
+	buf.Write(key)
+	buf.WriteByte(':')
+}
+
+// Encoder writes JSON Merge Patch documents to an output stream, mirroring
+// the API of encoding/json.Encoder.
+type Encoder struct {
+	w io.Writer
+}
+
+// NewEncoder returns a new Encoder that writes to w.
+func NewEncoder(w io.Writer) *Encoder {
+	return &Encoder{w: w}
+}
+
+// Encode writes the merge patch encoding of v to the stream, followed by a
+// newline character.
+func (e *Encoder) Encode(v any) error {
+	data, err := MarshalMergePatch(v)
+	if err != nil {
+		return err
+	}
+	if _, err := e.w.Write(data); err != nil {
+		return err
+	}
+	_, err = e.w.Write([]byte("\n"))
+	return err
+}
+
+// ApplyMergePatch applies patch to target per RFC 7396 and returns the
+// merged document: objects are merged recursively, non-object values are
+// replaced outright, and keys whose patch value is null are deleted from
+// the result.
+func ApplyMergePatch(target, patch []byte) ([]byte, error) {
+	var targetDoc any
+	if len(bytes.TrimSpace(target)) > 0 {
+		if err := json.Unmarshal(target, &targetDoc); err != nil {
+			return nil, fmt.Errorf("niljson: invalid merge patch target: %w", err)
+		}
+	}
+
+	var patchDoc any
+	if err := json.Unmarshal(patch, &patchDoc); err != nil {
+		return nil, fmt.Errorf("niljson: invalid merge patch: %w", err)
+	}
+
+	return json.Marshal(mergePatch(targetDoc, patchDoc))
+}
+
+// mergePatch implements the recursive MergePatch algorithm from RFC 7396
+// section 2.
+func mergePatch(target, patch any) any {
+	patchObj, ok := patch.(map[string]any)
+	if !ok {
+		return patch
+	}
+
+	targetObj, ok := target.(map[string]any)
+	if !ok {
+		targetObj = map[string]any{}
+	}
+
+	result := make(map[string]any, len(targetObj))
+	for key, value := range targetObj {
+		result[key] = value
+	}
+	for key, value := range patchObj {
+		if value == nil {
+			delete(result, key)
+			continue
+		}
+		result[key] = mergePatch(result[key], value)
+	}
+	return result
+}
@@ -0,0 +1,152 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestMarshalMergePatch(t *testing.T) {
+	type Patch struct {
+		Name    NilString `json:"name"`
+		Email   NilString `json:"email"`
+		Age     NilInt    `json:"age,omitempty"`
+		Comment string    `json:"comment,omitempty"`
+	}
+
+	patch := &Patch{}
+	patch.Name.Set("Alice")
+	patch.Email.Set("old@example.com")
+	patch.Email.Reset()
+
+	data, err := MarshalMergePatch(patch)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"name":"Alice","email":null}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestMarshalMergePatch_OmitsUnsetVariable(t *testing.T) {
+	type Patch struct {
+		Name NilString `json:"name"`
+		Age  NilInt    `json:"age"`
+	}
+
+	patch := &Patch{}
+	patch.Name.Set("Bob")
+	data, err := MarshalMergePatch(patch)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"name":"Bob"}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestMarshalMergePatch_DashNameIsNotSkipped(t *testing.T) {
+	type Patch struct {
+		Dash string `json:"-,"`
+	}
+
+	patch := &Patch{Dash: "hello"}
+	data, err := MarshalMergePatch(patch)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"-":"hello"}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestMarshalMergePatch_ValueNotPointer(t *testing.T) {
+	type Patch struct {
+		Name NilString `json:"name"`
+	}
+
+	patch := Patch{}
+	patch.Name.Set("Dave")
+	data, err := MarshalMergePatch(patch)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"name":"Dave"}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestEncoder_Encode(t *testing.T) {
+	type Patch struct {
+		Name NilString `json:"name"`
+	}
+
+	patch := &Patch{}
+	patch.Name.Set("Carol")
+
+	var buf bytes.Buffer
+	if err := NewEncoder(&buf).Encode(patch); err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := "{\"name\":\"Carol\"}\n"
+	if buf.String() != expected {
+		t.Errorf(ErrExpectedJSONString, expected, buf.String())
+	}
+}
+
+func TestApplyMergePatch(t *testing.T) {
+	target := []byte(`{"title":"Goodbye!","author":{"givenName":"John","familyName":"Doe"},"tags":["example","sample"],"content":"This will be unchanged"}`)
+	patch := []byte(`{"title":"Hello!","phoneNumber":"+01-123-456-7890","author":{"familyName":null},"tags":["example"]}`)
+
+	data, err := ApplyMergePatch(target, patch)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	var got map[string]any
+	if err := json.Unmarshal(data, &got); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+
+	if got["title"] != "Hello!" {
+		t.Errorf("expected title to be %q, got %q", "Hello!", got["title"])
+	}
+	if got["phoneNumber"] != "+01-123-456-7890" {
+		t.Errorf("expected phoneNumber to be set")
+	}
+	author, ok := got["author"].(map[string]any)
+	if !ok {
+		t.Fatal("expected author to be an object")
+	}
+	if _, exists := author["familyName"]; exists {
+		t.Error("expected author.familyName to be deleted")
+	}
+	if author["givenName"] != "John" {
+		t.Errorf("expected author.givenName to be unchanged")
+	}
+}
+
+func TestApplyMergePatch_EmptyTarget(t *testing.T) {
+	data, err := ApplyMergePatch(nil, []byte(`{"a":1}`))
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"a":1}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
@@ -81,8 +81,10 @@ func (v *Variable[T]) Reset() {
 	v.notNil = false
 }
 
-// Value returns the value of the Variable
-func (v *Variable[T]) Value() T {
+// Get returns the value of the Variable. This was named Value prior to
+// the addition of database/sql support; it was renamed so that Value()
+// could instead implement database/sql/driver.Valuer.
+func (v *Variable[T]) Get() T {
 	return v.value
 }
 
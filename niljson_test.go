@@ -57,8 +57,8 @@ func TestVariable_UnmarshalJSON_Boolean(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if !jt.Value.Value() {
-		t.Errorf("expected value to be true, got %t", jt.Value.Value())
+	if !jt.Value.Get() {
+		t.Errorf("expected value to be true, got %t", jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -103,8 +103,8 @@ func TestVariable_UnmarshalJSON_ByteSlice(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if !bytes.Equal(jt.Value.Value(), []byte("bytes")) {
-		t.Errorf("expected value to be %q, got %q", "bytes", jt.Value.Value())
+	if !bytes.Equal(jt.Value.Get(), []byte("bytes")) {
+		t.Errorf("expected value to be %q, got %q", "bytes", jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -150,8 +150,8 @@ func TestVariable_UnmarshalJSON_Float32(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf("expected value to be %f, got %f", expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf("expected value to be %f, got %f", expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -197,8 +197,8 @@ func TestVariable_UnmarshalJSON_Float64(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf("expected value to be %f, got %f", expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf("expected value to be %f, got %f", expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -244,8 +244,8 @@ func TestVariable_UnmarshalJSON_Int(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -291,8 +291,8 @@ func TestVariable_UnmarshalJSON_Int64(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -338,8 +338,8 @@ func TestVariable_UnmarshalJSON_String(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf("expected value to be %s, got %s", expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf("expected value to be %s, got %s", expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -385,8 +385,8 @@ func TestVariable_UnmarshalJSON_UInt(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -432,8 +432,8 @@ func TestVariable_UnmarshalJSON_UInt8(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -479,8 +479,8 @@ func TestVariable_UnmarshalJSON_UInt16(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -526,8 +526,8 @@ func TestVariable_UnmarshalJSON_UInt32(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -573,8 +573,8 @@ func TestVariable_UnmarshalJSON_UInt64(t *testing.T) {
 	if jt.NilValue.NotNil() {
 		t.Errorf(ErrExpectedNil)
 	}
-	if jt.Value.Value() != expected {
-		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Value())
+	if jt.Value.Get() != expected {
+		t.Errorf(ErrExpectedJSONInt, expected, jt.Value.Get())
 	}
 
 	jt.Value.Reset()
@@ -632,16 +632,16 @@ func ExampleVariable_UnmarshalJSON() {
 	}
 
 	if example.Bool.NotNil() {
-		output += fmt.Sprintf("Bool is: %t, ", example.Bool.Value())
+		output += fmt.Sprintf("Bool is: %t, ", example.Bool.Get())
 	}
 	if example.Float32.IsNil() {
 		output += "Float 32 is nil, "
 	}
 	if example.Float64.NotNil() {
-		output += fmt.Sprintf("Float 64 is: %f, ", example.Float64.Value())
+		output += fmt.Sprintf("Float 64 is: %f, ", example.Float64.Get())
 	}
 	if example.String.NotNil() {
-		output += fmt.Sprintf("String is: %s", example.String.Value())
+		output += fmt.Sprintf("String is: %s", example.String.Get())
 	}
 	fmt.Println(output)
 	// Output: Bool is: true, Float 32 is nil, Float 64 is: 0.000000, String is: test
@@ -0,0 +1,181 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// Scan satisfies the database/sql.Scanner interface, so a Variable can be
+// used as the destination of a *sql.Row/*sql.Rows Scan call. A src of nil
+// leaves the Variable IsNil(); otherwise src is converted to T, supporting
+// the usual driver-returned types (int64, float64, bool, []byte, string,
+// time.Time) as well as numeric widening and string parsing.
+func (v *Variable[T]) Scan(src any) error {
+	v.present = true
+	if src == nil {
+		var zero T
+		v.value = zero
+		v.notNil = false
+		return nil
+	}
+
+	value, err := scanValue[T](src)
+	if err != nil {
+		return err
+	}
+	v.value = value
+	v.notNil = true
+	return nil
+}
+
+// Value satisfies the database/sql/driver.Valuer interface. It returns nil
+// when the Variable IsNil(), otherwise the underlying value coerced to one
+// of the types the database/sql/driver package supports natively.
+func (v *Variable[T]) Value() (driver.Value, error) {
+	if v.IsNil() {
+		return nil, nil
+	}
+
+	if t, ok := any(v.value).(time.Time); ok {
+		return t, nil
+	}
+	if b, ok := any(v.value).([]byte); ok {
+		return b, nil
+	}
+
+	rv := reflect.ValueOf(v.value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return rv.Int(), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int64(rv.Uint()), nil
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), nil
+	case reflect.Bool:
+		return rv.Bool(), nil
+	case reflect.String:
+		return rv.String(), nil
+	default:
+		return nil, fmt.Errorf("niljson: unsupported driver.Value type %T", v.value)
+	}
+}
+
+// scanValue converts a database/sql.Scan source value to T.
+func scanValue[T any](src any) (T, error) {
+	var zero T
+
+	if text, ok := scanText(src); ok {
+		return parseScanText[T](text, zero)
+	}
+
+	switch s := src.(type) {
+	case time.Time:
+		if value, ok := any(s).(T); ok {
+			return value, nil
+		}
+	case bool:
+		if value, ok := any(s).(T); ok {
+			return value, nil
+		}
+	case int64:
+		return convertScanNumber[T](reflect.ValueOf(s))
+	case float64:
+		return convertScanNumber[T](reflect.ValueOf(s))
+	}
+
+	return zero, fmt.Errorf("niljson: unsupported Scan source type %T", src)
+}
+
+// scanText reports whether src is a textual driver value ([]byte or
+// string) and, if so, returns its string form.
+func scanText(src any) (string, bool) {
+	switch s := src.(type) {
+	case []byte:
+		return string(s), true
+	case string:
+		return s, true
+	default:
+		return "", false
+	}
+}
+
+// parseScanText parses text into T, dispatching on T's kind. zero is T's
+// zero value, used to determine the target kind.
+func parseScanText[T any](text string, zero T) (T, error) {
+	target := reflect.TypeOf(zero)
+	if target == nil {
+		return zero, fmt.Errorf("niljson: cannot scan into untyped destination")
+	}
+
+	switch target.Kind() {
+	case reflect.String:
+		return any(text).(T), nil
+	case reflect.Bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return zero, err
+		}
+		return reflect.ValueOf(parsed).Convert(target).Interface().(T), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		parsed, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return zero, err
+		}
+		return reflect.ValueOf(parsed).Convert(target).Interface().(T), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		parsed, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return zero, err
+		}
+		return reflect.ValueOf(parsed).Convert(target).Interface().(T), nil
+	case reflect.Float32, reflect.Float64:
+		parsed, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return zero, err
+		}
+		return reflect.ValueOf(parsed).Convert(target).Interface().(T), nil
+	case reflect.Slice:
+		if target.Elem().Kind() == reflect.Uint8 {
+			return any([]byte(text)).(T), nil
+		}
+	}
+	return zero, fmt.Errorf("niljson: cannot convert string to %s", target)
+}
+
+// convertScanNumber converts a numeric driver value (int64 or float64) to
+// T via reflection, covering the case where the driver's native numeric
+// type doesn't exactly match T (e.g. driver int64 into a NilInt).
+func convertScanNumber[T any](src reflect.Value) (T, error) {
+	var zero T
+	target := reflect.TypeOf(zero)
+	if target == nil {
+		return zero, fmt.Errorf("niljson: cannot scan into untyped destination")
+	}
+
+	// reflect.Value.Convert applies Go's int->string rune conversion here,
+	// not a decimal format, so format numeric sources explicitly instead.
+	if target.Kind() == reflect.String {
+		var text string
+		switch src.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			text = strconv.FormatInt(src.Int(), 10)
+		case reflect.Float32, reflect.Float64:
+			text = strconv.FormatFloat(src.Float(), 'f', -1, 64)
+		default:
+			return zero, fmt.Errorf("niljson: cannot convert %s to %s", src.Type(), target)
+		}
+		return any(text).(T), nil
+	}
+
+	if !src.Type().ConvertibleTo(target) {
+		return zero, fmt.Errorf("niljson: cannot convert %s to %s", src.Type(), target)
+	}
+	return src.Convert(target).Interface().(T), nil
+}
@@ -0,0 +1,134 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"testing"
+	"time"
+)
+
+func TestVariable_Scan_Nil(t *testing.T) {
+	var v NilString
+	if err := v.Scan(nil); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if v.NotNil() {
+		t.Errorf(ErrExpectedNil)
+	}
+	if v.Omitted() {
+		t.Error("expected Scan to mark the Variable as present")
+	}
+}
+
+func TestVariable_Scan_NativeTypes(t *testing.T) {
+	var b NilBoolean
+	if err := b.Scan(true); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !b.Get() {
+		t.Errorf(ErrExpectedValue)
+	}
+
+	now := time.Now()
+	var ts Variable[time.Time]
+	if err := ts.Scan(now); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !ts.Get().Equal(now) {
+		t.Errorf("expected time to be %s, got %s", now, ts.Get())
+	}
+}
+
+func TestVariable_Scan_NumericWidening(t *testing.T) {
+	var i NilInt
+	if err := i.Scan(int64(42)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if i.Get() != 42 {
+		t.Errorf(ErrExpectedJSONInt, 42, i.Get())
+	}
+
+	var f NilFloat32
+	if err := f.Scan(float64(1.5)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if f.Get() != 1.5 {
+		t.Errorf("expected value to be 1.5, got %f", f.Get())
+	}
+}
+
+func TestVariable_Scan_StringAndBytes(t *testing.T) {
+	var i NilInt64
+	if err := i.Scan([]byte("12345")); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if i.Get() != 12345 {
+		t.Errorf(ErrExpectedJSONInt, 12345, i.Get())
+	}
+
+	var s NilString
+	if err := s.Scan("hello"); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s.Get() != "hello" {
+		t.Errorf("expected value to be %q, got %q", "hello", s.Get())
+	}
+}
+
+func TestVariable_Scan_NumberIntoString(t *testing.T) {
+	var s NilString
+	if err := s.Scan(int64(65)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if s.Get() != "65" {
+		t.Errorf("expected value to be %q, got %q", "65", s.Get())
+	}
+
+	var f NilString
+	if err := f.Scan(float64(1.5)); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if f.Get() != "1.5" {
+		t.Errorf("expected value to be %q, got %q", "1.5", f.Get())
+	}
+}
+
+func TestVariable_Scan_Unsupported(t *testing.T) {
+	var s NilString
+	if err := s.Scan(struct{}{}); err == nil {
+		t.Error("expected error when scanning an unsupported source type")
+	}
+}
+
+func TestVariable_Value_Nil(t *testing.T) {
+	var s NilString
+	value, err := s.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if value != nil {
+		t.Errorf("expected driver value to be nil, got %v", value)
+	}
+}
+
+func TestVariable_Value_NativeTypes(t *testing.T) {
+	i := NewVariable(int64(42))
+	value, err := i.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if value != int64(42) {
+		t.Errorf("expected driver value to be %d, got %v", 42, value)
+	}
+
+	small := NewVariable(uint8(7))
+	value, err = small.Value()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if value != int64(7) {
+		t.Errorf("expected driver value to be %d, got %v", 7, value)
+	}
+}
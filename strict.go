@@ -0,0 +1,135 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// requiredTracker is satisfied by Variable[T] (and types that embed it,
+// such as StringEncoded[T]) as well as Union2/Union3. UnmarshalStrict uses
+// it to tell an omitted field from one that was actually decoded.
+type requiredTracker interface {
+	Omitted() bool
+}
+
+// RequiredFieldError is returned by UnmarshalStrict when one or more
+// fields tagged `,required` were omitted from the JSON document. Paths
+// are JSON pointers (RFC 6901) to the missing fields.
+type RequiredFieldError struct {
+	Paths []string
+}
+
+// Error satisfies the error interface for RequiredFieldError
+func (e *RequiredFieldError) Error() string {
+	messages := make([]string, len(e.Paths))
+	for i, path := range e.Paths {
+		messages[i] = fmt.Sprintf("%s: required field missing", path)
+	}
+	return strings.Join(messages, "; ")
+}
+
+// UnmarshalStrict unmarshals data into v like json.Unmarshal, additionally
+// rejecting JSON objects that contain fields with no corresponding struct
+// field (like json.Decoder.DisallowUnknownFields), then walks v via
+// reflection and returns a *RequiredFieldError listing every field tagged
+// `json:"name,required"` whose Omitted() is still true after decoding.
+func UnmarshalStrict(data []byte, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return err
+	}
+
+	var missing []string
+	walkRequiredFields(reflect.ValueOf(v), "", &missing)
+	if len(missing) > 0 {
+		return &RequiredFieldError{Paths: missing}
+	}
+	return nil
+}
+
+// walkRequiredFields recursively visits the fields of the struct rv points
+// to (or is), appending the JSON pointer path of every `,required` field
+// that is Omitted() to missing.
+func walkRequiredFields(rv reflect.Value, path string, missing *[]string) {
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return
+	}
+
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+
+		name, required, skip := jsonNameAndRequired(field)
+		if skip {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fieldPath := path + "/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(name)
+		fv := rv.Field(i)
+
+		if required {
+			if tracker, ok := requiredFieldTracker(fv); ok {
+				if tracker.Omitted() {
+					*missing = append(*missing, fieldPath)
+				}
+				continue
+			}
+		}
+		walkRequiredFields(fv, fieldPath, missing)
+	}
+}
+
+// requiredFieldTracker returns fv as a requiredTracker if its address
+// implements the interface, which is how Variable[T]'s pointer-receiver
+// Omitted method is reached through reflection.
+func requiredFieldTracker(fv reflect.Value) (requiredTracker, bool) {
+	if !fv.CanAddr() {
+		return nil, false
+	}
+	tracker, ok := fv.Addr().Interface().(requiredTracker)
+	return tracker, ok
+}
+
+// jsonNameAndRequired extracts the field name and the "required" option
+// from a struct field's json tag. name is empty when the tag gives none,
+// in which case callers use the Go field name. skip reports whether the
+// field should be left out
+// entirely, which per encoding/json semantics is true only for the
+// literal tag `json:"-"` — `json:"-,"` names the field "-" and is not
+// skipped.
+func jsonNameAndRequired(field reflect.StructField) (name string, required, skip bool) {
+	tag := field.Tag.Get("json")
+	if tag == "" {
+		return "", false, false
+	}
+	if tag == "-" {
+		return "", false, true
+	}
+	parts := strings.Split(tag, ",")
+	name = parts[0]
+	for _, opt := range parts[1:] {
+		if opt == "required" {
+			required = true
+		}
+	}
+	return name, required, false
+}
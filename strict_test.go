@@ -0,0 +1,78 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestUnmarshalStrict_MissingRequiredField(t *testing.T) {
+	type Address struct {
+		Zip NilString `json:"zip,required"`
+	}
+	type User struct {
+		Name    NilString `json:"name,required"`
+		Address Address   `json:"address"`
+	}
+
+	var user User
+	err := UnmarshalStrict([]byte(`{"name":"Alice","address":{}}`), &user)
+	if err == nil {
+		t.Fatal("expected an error for the missing required field")
+	}
+
+	var reqErr *RequiredFieldError
+	if !errors.As(err, &reqErr) {
+		t.Fatalf("expected a *RequiredFieldError, got %T", err)
+	}
+	if len(reqErr.Paths) != 1 || reqErr.Paths[0] != "/address/zip" {
+		t.Errorf("expected missing paths to be [%q], got %v", "/address/zip", reqErr.Paths)
+	}
+}
+
+func TestUnmarshalStrict_AllRequiredFieldsPresent(t *testing.T) {
+	type User struct {
+		Name  NilString `json:"name,required"`
+		Email NilString `json:"email,required"`
+	}
+
+	var user User
+	err := UnmarshalStrict([]byte(`{"name":"Alice","email":null}`), &user)
+	if err != nil {
+		t.Errorf("expected no error, got %s", err)
+	}
+}
+
+func TestUnmarshalStrict_DashNameIsNotSkipped(t *testing.T) {
+	type User struct {
+		Dash NilString `json:"-,required"`
+	}
+
+	var user User
+	err := UnmarshalStrict([]byte(`{}`), &user)
+	if err == nil {
+		t.Fatal("expected an error for the missing required field named \"-\"")
+	}
+
+	var reqErr *RequiredFieldError
+	if !errors.As(err, &reqErr) {
+		t.Fatalf("expected a *RequiredFieldError, got %T", err)
+	}
+	if len(reqErr.Paths) != 1 || reqErr.Paths[0] != "/-" {
+		t.Errorf("expected missing paths to be [%q], got %v", "/-", reqErr.Paths)
+	}
+}
+
+func TestUnmarshalStrict_UnknownField(t *testing.T) {
+	type User struct {
+		Name NilString `json:"name"`
+	}
+
+	var user User
+	if err := UnmarshalStrict([]byte(`{"name":"Alice","extra":true}`), &user); err == nil {
+		t.Error("expected an error for the unknown field")
+	}
+}
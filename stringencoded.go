@@ -0,0 +1,141 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// NilStringInt64 is an int64 type that can be nil and accepts both native
+// JSON numbers and the same value quoted as a JSON string, e.g. "12345"
+type NilStringInt64 = StringEncoded[int64]
+
+// NilStringUInt64 is an uint64 type that can be nil and accepts both native
+// JSON numbers and the same value quoted as a JSON string, e.g. "12345"
+type NilStringUInt64 = StringEncoded[uint64]
+
+// NilStringFloat64 is a float64 type that can be nil and accepts both native
+// JSON numbers and the same value quoted as a JSON string, e.g. "1.23"
+type NilStringFloat64 = StringEncoded[float64]
+
+// NilStringBool is a bool type that can be nil and accepts both native JSON
+// booleans and the same value quoted as a JSON string, e.g. "true"
+type NilStringBool = StringEncoded[bool]
+
+// StringEncodable is a constraint for the underlying types StringEncoded
+// supports.
+type StringEncodable interface {
+	int64 | uint64 | float64 | bool
+}
+
+// StringEncoded is a Variable that transparently accepts JSON numbers and
+// booleans quoted as JSON strings on unmarshal, which is how APIs such as
+// Stripe or GitHub encode values that might exceed JavaScript's 53-bit
+// integer precision. It keeps the tri-state nil/omitted/present semantics
+// of Variable, but always marshals its value wrapped in quotes.
+type StringEncoded[T StringEncodable] struct {
+	Variable[T]
+}
+
+// NewStringEncoded returns a new StringEncoded of generic type
+func NewStringEncoded[T StringEncodable](value T) StringEncoded[T] {
+	return StringEncoded[T]{Variable: NewVariable(value)}
+}
+
+// MarshalJSON satisfies the json.Marshaler interface for StringEncoded types
+func (v *StringEncoded[T]) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return json.Marshal(nil)
+	}
+	text, err := formatStringEncoded(v.Get())
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(text)
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface for StringEncoded
+// types. It accepts either the native JSON type or the same value quoted
+// as a JSON string, and rejects empty strings.
+func (v *StringEncoded[T]) UnmarshalJSON(data []byte) error {
+	v.present = true
+	if string(data) == "null" {
+		return nil
+	}
+
+	if len(data) > 0 && data[0] == '"' {
+		var text string
+		if err := json.Unmarshal(data, &text); err != nil {
+			return err
+		}
+		if text == "" {
+			return fmt.Errorf("niljson: string-encoded value must not be empty")
+		}
+		value, err := parseStringEncoded[T](text)
+		if err != nil {
+			return err
+		}
+		v.value = value
+		v.notNil = true
+		return nil
+	}
+
+	v.value = *new(T)
+	v.notNil = true
+	return json.Unmarshal(data, &v.value)
+}
+
+// formatStringEncoded renders value as the text that is wrapped in quotes
+// when a StringEncoded is marshaled.
+func formatStringEncoded[T StringEncodable](value T) (string, error) {
+	switch val := any(value).(type) {
+	case int64:
+		return strconv.FormatInt(val, 10), nil
+	case uint64:
+		return strconv.FormatUint(val, 10), nil
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64), nil
+	case bool:
+		return strconv.FormatBool(val), nil
+	default:
+		return "", fmt.Errorf("niljson: unsupported string-encoded type: %T", value)
+	}
+}
+
+// parseStringEncoded parses text, the unquoted contents of a JSON string,
+// into the underlying type T of a StringEncoded.
+func parseStringEncoded[T StringEncodable](text string) (T, error) {
+	var zero T
+	switch any(zero).(type) {
+	case int64:
+		parsed, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return zero, err
+		}
+		return any(parsed).(T), nil
+	case uint64:
+		parsed, err := strconv.ParseUint(text, 10, 64)
+		if err != nil {
+			return zero, err
+		}
+		return any(parsed).(T), nil
+	case float64:
+		parsed, err := strconv.ParseFloat(text, 64)
+		if err != nil {
+			return zero, err
+		}
+		return any(parsed).(T), nil
+	case bool:
+		parsed, err := strconv.ParseBool(text)
+		if err != nil {
+			return zero, err
+		}
+		return any(parsed).(T), nil
+	default:
+		return zero, fmt.Errorf("niljson: unsupported string-encoded type: %T", zero)
+	}
+}
@@ -0,0 +1,151 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+var stringEncodedJSONBytes = []byte(
+	`{
+		"int64_native": 12345678901234,
+		"int64_string": "12345678901234",
+		"uint64_string": "18446744073709551615",
+		"float64_string": "123.456",
+		"bool_string": "true",
+		"empty_string": "",
+		"nilvalue": null
+	}`)
+
+func TestStringEncoded_UnmarshalJSON_Int64(t *testing.T) {
+	type JSONType struct {
+		Native   NilStringInt64 `json:"int64_native"`
+		Quoted   NilStringInt64 `json:"int64_string"`
+		NilValue NilStringInt64 `json:"nilvalue,omitempty"`
+	}
+	expected := int64(12345678901234)
+
+	var jt JSONType
+	if err := json.Unmarshal(stringEncodedJSONBytes, &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	if jt.Native.IsNil() || jt.Native.Get() != expected {
+		t.Errorf("expected native value to be %d, got %d", expected, jt.Native.Get())
+	}
+	if jt.Quoted.IsNil() || jt.Quoted.Get() != expected {
+		t.Errorf("expected quoted value to be %d, got %d", expected, jt.Quoted.Get())
+	}
+	if jt.NilValue.NotNil() {
+		t.Errorf(ErrExpectedNil)
+	}
+}
+
+func TestStringEncoded_MarshalJSON_Int64(t *testing.T) {
+	type JSONType struct {
+		Value    NilStringInt64 `json:"int64"`
+		NilValue NilStringInt64 `json:"nilvalue,omitempty"`
+	}
+
+	expected := `{"int64":"12345678901234","nilvalue":null}`
+	jt := &JSONType{
+		Value: NewStringEncoded(int64(12345678901234)),
+	}
+	data, err := json.Marshal(jt)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestStringEncoded_UnmarshalJSON_UInt64(t *testing.T) {
+	type JSONType struct {
+		Value NilStringUInt64 `json:"uint64_string"`
+	}
+	expected := uint64(18446744073709551615)
+
+	var jt JSONType
+	if err := json.Unmarshal(stringEncodedJSONBytes, &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	if jt.Value.IsNil() || jt.Value.Get() != expected {
+		t.Errorf("expected value to be %d, got %d", expected, jt.Value.Get())
+	}
+}
+
+func TestStringEncoded_MarshalJSON_UInt64(t *testing.T) {
+	type JSONType struct {
+		Value NilStringUInt64 `json:"uint64"`
+	}
+
+	expected := `{"uint64":"18446744073709551615"}`
+	jt := &JSONType{Value: NewStringEncoded(uint64(18446744073709551615))}
+	data, err := json.Marshal(jt)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestStringEncoded_UnmarshalJSON_Float64(t *testing.T) {
+	type JSONType struct {
+		Value NilStringFloat64 `json:"float64_string"`
+	}
+	expected := 123.456
+
+	var jt JSONType
+	if err := json.Unmarshal(stringEncodedJSONBytes, &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	if jt.Value.IsNil() || jt.Value.Get() != expected {
+		t.Errorf("expected value to be %f, got %f", expected, jt.Value.Get())
+	}
+}
+
+func TestStringEncoded_UnmarshalJSON_Bool(t *testing.T) {
+	type JSONType struct {
+		Value NilStringBool `json:"bool_string"`
+	}
+
+	var jt JSONType
+	if err := json.Unmarshal(stringEncodedJSONBytes, &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	if jt.Value.IsNil() || !jt.Value.Get() {
+		t.Errorf(ErrExpectedValue)
+	}
+}
+
+func TestStringEncoded_MarshalJSON_Bool(t *testing.T) {
+	type JSONType struct {
+		Value NilStringBool `json:"bool"`
+	}
+
+	expected := `{"bool":"true"}`
+	jt := &JSONType{Value: NewStringEncoded(true)}
+	data, err := json.Marshal(jt)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestStringEncoded_UnmarshalJSON_EmptyStringRejected(t *testing.T) {
+	type JSONType struct {
+		Value NilStringInt64 `json:"empty_string"`
+	}
+
+	var jt JSONType
+	if err := json.Unmarshal(stringEncodedJSONBytes, &jt); err == nil {
+		t.Error("expected error when unmarshaling an empty string, got nil")
+	}
+}
@@ -0,0 +1,171 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// NilTime is a time.Time type that can be nil. time.Time already
+// implements json.Marshaler/json.Unmarshaler using RFC3339Nano, which
+// Variable's generic (un)marshaling delegates to. Use NilRFC3339Time,
+// NilUnixTime, or NilUnixMilliTime instead when the API encodes time in
+// one of those other common shapes.
+type NilTime = Variable[time.Time]
+
+// DefaultTimeLayout is the layout used by NilRFC3339Time when parsing and
+// formatting its string representation.
+var DefaultTimeLayout = time.RFC3339Nano
+
+// NilRFC3339Time is a time.Time type that can be nil and (un)marshals as a
+// string, parsed and formatted using DefaultTimeLayout.
+type NilRFC3339Time struct {
+	Variable[time.Time]
+}
+
+// MarshalJSON satisfies the json.Marshaler interface for NilRFC3339Time
+func (v *NilRFC3339Time) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(v.Get().Format(DefaultTimeLayout))
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface for
+// NilRFC3339Time
+func (v *NilRFC3339Time) UnmarshalJSON(data []byte) error {
+	v.present = true
+	if string(data) == "null" {
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	parsed, err := time.Parse(DefaultTimeLayout, text)
+	if err != nil {
+		return err
+	}
+	v.value = parsed
+	v.notNil = true
+	return nil
+}
+
+// NilUnixTime is a time.Time type that can be nil and (un)marshals as a
+// JSON number of Unix seconds.
+type NilUnixTime struct {
+	Variable[time.Time]
+}
+
+// MarshalJSON satisfies the json.Marshaler interface for NilUnixTime
+func (v *NilUnixTime) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(v.Get().Unix())
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface for NilUnixTime
+func (v *NilUnixTime) UnmarshalJSON(data []byte) error {
+	v.present = true
+	if string(data) == "null" {
+		return nil
+	}
+
+	var seconds int64
+	if err := json.Unmarshal(data, &seconds); err != nil {
+		return err
+	}
+	v.value = time.Unix(seconds, 0)
+	v.notNil = true
+	return nil
+}
+
+// NilUnixMilliTime is a time.Time type that can be nil and (un)marshals as
+// a JSON number of Unix milliseconds.
+type NilUnixMilliTime struct {
+	Variable[time.Time]
+}
+
+// MarshalJSON satisfies the json.Marshaler interface for NilUnixMilliTime
+func (v *NilUnixMilliTime) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return json.Marshal(nil)
+	}
+	return json.Marshal(v.Get().UnixMilli())
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface for
+// NilUnixMilliTime
+func (v *NilUnixMilliTime) UnmarshalJSON(data []byte) error {
+	v.present = true
+	if string(data) == "null" {
+		return nil
+	}
+
+	var millis int64
+	if err := json.Unmarshal(data, &millis); err != nil {
+		return err
+	}
+	v.value = time.UnixMilli(millis)
+	v.notNil = true
+	return nil
+}
+
+// DefaultDurationNumeric controls whether NilDuration (un)marshals using
+// time.ParseDuration/time.Duration.String syntax (the default, false) or
+// as a raw integer nanosecond count (true).
+var DefaultDurationNumeric = false
+
+// NilDuration is a time.Duration type that can be nil. By default it
+// (un)marshals using the time.ParseDuration/time.Duration.String syntax,
+// e.g. "1h30m"; set DefaultDurationNumeric to true to use a raw integer
+// nanosecond count instead.
+type NilDuration struct {
+	Variable[time.Duration]
+}
+
+// MarshalJSON satisfies the json.Marshaler interface for NilDuration
+func (v *NilDuration) MarshalJSON() ([]byte, error) {
+	if v.IsNil() {
+		return json.Marshal(nil)
+	}
+	if DefaultDurationNumeric {
+		return json.Marshal(int64(v.Get()))
+	}
+	return json.Marshal(v.Get().String())
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface for NilDuration
+func (v *NilDuration) UnmarshalJSON(data []byte) error {
+	v.present = true
+	if string(data) == "null" {
+		return nil
+	}
+
+	if DefaultDurationNumeric {
+		var nanos int64
+		if err := json.Unmarshal(data, &nanos); err != nil {
+			return err
+		}
+		v.value = time.Duration(nanos)
+		v.notNil = true
+		return nil
+	}
+
+	var text string
+	if err := json.Unmarshal(data, &text); err != nil {
+		return err
+	}
+	parsed, err := time.ParseDuration(text)
+	if err != nil {
+		return err
+	}
+	v.value = parsed
+	v.notNil = true
+	return nil
+}
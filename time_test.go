@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestNilTime_UnmarshalJSON(t *testing.T) {
+	type JSONType struct {
+		Value    NilTime `json:"value"`
+		NilValue NilTime `json:"nilvalue,omitempty"`
+	}
+
+	data := []byte(`{"value":"2024-01-02T15:04:05Z","nilvalue":null}`)
+	var jt JSONType
+	if err := json.Unmarshal(data, &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	if jt.Value.IsNil() {
+		t.Errorf(ErrExpectedValue)
+	}
+	expected := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	if !jt.Value.Get().Equal(expected) {
+		t.Errorf("expected value to be %s, got %s", expected, jt.Value.Get())
+	}
+	if jt.NilValue.NotNil() {
+		t.Errorf(ErrExpectedNil)
+	}
+}
+
+func TestNilRFC3339Time_MarshalJSON(t *testing.T) {
+	type JSONType struct {
+		Value NilRFC3339Time `json:"value"`
+	}
+
+	jt := &JSONType{}
+	jt.Value.Set(time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC))
+	data, err := json.Marshal(jt)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"value":"2024-01-02T15:04:05Z"}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestNilRFC3339Time_UnmarshalJSON(t *testing.T) {
+	type JSONType struct {
+		Value    NilRFC3339Time `json:"value"`
+		NilValue NilRFC3339Time `json:"nilvalue,omitempty"`
+	}
+
+	data := []byte(`{"value":"2024-01-02T15:04:05Z","nilvalue":null}`)
+	var jt JSONType
+	if err := json.Unmarshal(data, &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	if jt.Value.IsNil() {
+		t.Errorf(ErrExpectedValue)
+	}
+	expected := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	if !jt.Value.Get().Equal(expected) {
+		t.Errorf("expected value to be %s, got %s", expected, jt.Value.Get())
+	}
+	if jt.NilValue.NotNil() {
+		t.Errorf(ErrExpectedNil)
+	}
+}
+
+func TestNilUnixTime_UnmarshalJSON(t *testing.T) {
+	type JSONType struct {
+		Value NilUnixTime `json:"value"`
+	}
+
+	var jt JSONType
+	if err := json.Unmarshal([]byte(`{"value":1704207845}`), &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	expected := time.Unix(1704207845, 0)
+	if !jt.Value.Get().Equal(expected) {
+		t.Errorf("expected value to be %s, got %s", expected, jt.Value.Get())
+	}
+}
+
+func TestNilUnixTime_MarshalJSON(t *testing.T) {
+	type JSONType struct {
+		Value NilUnixTime `json:"value"`
+	}
+
+	jt := &JSONType{}
+	jt.Value.Set(time.Unix(1704207845, 0))
+	data, err := json.Marshal(jt)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"value":1704207845}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestNilUnixMilliTime_MarshalJSON(t *testing.T) {
+	type JSONType struct {
+		Value NilUnixMilliTime `json:"value"`
+	}
+
+	jt := &JSONType{}
+	jt.Value.Set(time.UnixMilli(1704207845123))
+	data, err := json.Marshal(jt)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"value":1704207845123}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestNilUnixMilliTime_UnmarshalJSON(t *testing.T) {
+	type JSONType struct {
+		Value    NilUnixMilliTime `json:"value"`
+		NilValue NilUnixMilliTime `json:"nilvalue,omitempty"`
+	}
+
+	data := []byte(`{"value":1704207845123,"nilvalue":null}`)
+	var jt JSONType
+	if err := json.Unmarshal(data, &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	expected := time.UnixMilli(1704207845123)
+	if !jt.Value.Get().Equal(expected) {
+		t.Errorf("expected value to be %s, got %s", expected, jt.Value.Get())
+	}
+	if jt.NilValue.NotNil() {
+		t.Errorf(ErrExpectedNil)
+	}
+}
+
+func TestNilDuration_UnmarshalJSON_String(t *testing.T) {
+	type JSONType struct {
+		Value NilDuration `json:"value"`
+	}
+
+	var jt JSONType
+	if err := json.Unmarshal([]byte(`{"value":"1h30m"}`), &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	expected := 90 * time.Minute
+	if jt.Value.Get() != expected {
+		t.Errorf("expected value to be %s, got %s", expected, jt.Value.Get())
+	}
+}
+
+func TestNilDuration_MarshalJSON_Numeric(t *testing.T) {
+	DefaultDurationNumeric = true
+	defer func() { DefaultDurationNumeric = false }()
+
+	type JSONType struct {
+		Value NilDuration `json:"value"`
+	}
+
+	jt := &JSONType{}
+	jt.Value.Set(90 * time.Minute)
+	data, err := json.Marshal(jt)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"value":5400000000000}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
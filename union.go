@@ -0,0 +1,249 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"encoding/json"
+)
+
+// Which arm of a Union is currently set. UnionNone means the Union is
+// either omitted or explicitly null.
+const (
+	UnionNone = iota
+	UnionA
+	UnionB
+	UnionC
+)
+
+// NilStringOrInt is the most common use of Union2: a field that APIs
+// encode as either a JSON string or a JSON number, e.g. an ID that is
+// sometimes a slug and sometimes a numeric identifier.
+type NilStringOrInt = Union2[string, int64]
+
+// nilState holds the nil/omitted tri-state bookkeeping shared by Union2
+// and Union3, so the state machine itself only needs to be maintained in
+// one place.
+type nilState struct {
+	notNil  bool
+	present bool
+}
+
+// IsNil returns true when the value is nil
+func (n *nilState) IsNil() bool {
+	return !n.notNil
+}
+
+// NotNil returns true when the value is not nil
+func (n *nilState) NotNil() bool {
+	return n.notNil
+}
+
+// Omitted returns true if the value was omitted in the JSON
+func (n *nilState) Omitted() bool {
+	return !n.present
+}
+
+// Union2 represents a JSON value that may decode as one of two different
+// Go types, combined with the nil/omitted tri-state tracking used
+// throughout niljson: a Union2 can be omitted, explicitly null, or hold
+// exactly one of its two arms.
+type Union2[A, B any] struct {
+	nilState
+	a     A
+	b     B
+	which int
+}
+
+// Which returns which arm of the Union2 is currently set: UnionA, UnionB,
+// or UnionNone if the Union2 is omitted or nil.
+func (u *Union2[A, B]) Which() int {
+	return u.which
+}
+
+// A returns the first arm's value and whether it is the arm that is set.
+func (u *Union2[A, B]) A() (A, bool) {
+	return u.a, u.which == UnionA
+}
+
+// B returns the second arm's value and whether it is the arm that is set.
+func (u *Union2[A, B]) B() (B, bool) {
+	return u.b, u.which == UnionB
+}
+
+// SetA makes the Union2 valid with the given value in its first arm.
+func (u *Union2[A, B]) SetA(value A) {
+	u.a = value
+	u.which = UnionA
+	u.present = true
+	u.notNil = true
+}
+
+// SetB makes the Union2 valid with the given value in its second arm.
+func (u *Union2[A, B]) SetB(value B) {
+	u.b = value
+	u.which = UnionB
+	u.present = true
+	u.notNil = true
+}
+
+// Reset resets the Union2 to a zero value and sets it to be nil
+func (u *Union2[A, B]) Reset() {
+	var zeroA A
+	var zeroB B
+	u.a, u.b = zeroA, zeroB
+	u.which = UnionNone
+	u.notNil = false
+}
+
+// MarshalJSON satisfies the json.Marshaler interface for Union2
+func (u *Union2[A, B]) MarshalJSON() ([]byte, error) {
+	switch u.which {
+	case UnionA:
+		return json.Marshal(u.a)
+	case UnionB:
+		return json.Marshal(u.b)
+	default:
+		return json.Marshal(nil)
+	}
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface for Union2. It
+// tries decoding into A first, falling back to B if that fails.
+func (u *Union2[A, B]) UnmarshalJSON(data []byte) error {
+	u.present = true
+	if string(data) == "null" {
+		return nil
+	}
+
+	var a A
+	if err := json.Unmarshal(data, &a); err == nil {
+		u.a = a
+		u.which = UnionA
+		u.notNil = true
+		return nil
+	}
+
+	var b B
+	if err := json.Unmarshal(data, &b); err != nil {
+		return err
+	}
+	u.b = b
+	u.which = UnionB
+	u.notNil = true
+	return nil
+}
+
+// Union3 represents a JSON value that may decode as one of three
+// different Go types. It behaves like Union2, but tries A, then B, then C.
+type Union3[A, B, C any] struct {
+	nilState
+	a     A
+	b     B
+	c     C
+	which int
+}
+
+// Which returns which arm of the Union3 is currently set: UnionA, UnionB,
+// UnionC, or UnionNone if the Union3 is omitted or nil.
+func (u *Union3[A, B, C]) Which() int {
+	return u.which
+}
+
+// A returns the first arm's value and whether it is the arm that is set.
+func (u *Union3[A, B, C]) A() (A, bool) {
+	return u.a, u.which == UnionA
+}
+
+// B returns the second arm's value and whether it is the arm that is set.
+func (u *Union3[A, B, C]) B() (B, bool) {
+	return u.b, u.which == UnionB
+}
+
+// C returns the third arm's value and whether it is the arm that is set.
+func (u *Union3[A, B, C]) C() (C, bool) {
+	return u.c, u.which == UnionC
+}
+
+// SetA makes the Union3 valid with the given value in its first arm.
+func (u *Union3[A, B, C]) SetA(value A) {
+	u.a = value
+	u.which = UnionA
+	u.present = true
+	u.notNil = true
+}
+
+// SetB makes the Union3 valid with the given value in its second arm.
+func (u *Union3[A, B, C]) SetB(value B) {
+	u.b = value
+	u.which = UnionB
+	u.present = true
+	u.notNil = true
+}
+
+// SetC makes the Union3 valid with the given value in its third arm.
+func (u *Union3[A, B, C]) SetC(value C) {
+	u.c = value
+	u.which = UnionC
+	u.present = true
+	u.notNil = true
+}
+
+// Reset resets the Union3 to a zero value and sets it to be nil
+func (u *Union3[A, B, C]) Reset() {
+	var zeroA A
+	var zeroB B
+	var zeroC C
+	u.a, u.b, u.c = zeroA, zeroB, zeroC
+	u.which = UnionNone
+	u.notNil = false
+}
+
+// MarshalJSON satisfies the json.Marshaler interface for Union3
+func (u *Union3[A, B, C]) MarshalJSON() ([]byte, error) {
+	switch u.which {
+	case UnionA:
+		return json.Marshal(u.a)
+	case UnionB:
+		return json.Marshal(u.b)
+	case UnionC:
+		return json.Marshal(u.c)
+	default:
+		return json.Marshal(nil)
+	}
+}
+
+// UnmarshalJSON satisfies the json.Unmarshaler interface for Union3. It
+// tries decoding into A first, then B, then C.
+func (u *Union3[A, B, C]) UnmarshalJSON(data []byte) error {
+	u.present = true
+	if string(data) == "null" {
+		return nil
+	}
+
+	var a A
+	if err := json.Unmarshal(data, &a); err == nil {
+		u.a = a
+		u.which = UnionA
+		u.notNil = true
+		return nil
+	}
+
+	var b B
+	if err := json.Unmarshal(data, &b); err == nil {
+		u.b = b
+		u.which = UnionB
+		u.notNil = true
+		return nil
+	}
+
+	var c C
+	if err := json.Unmarshal(data, &c); err != nil {
+		return err
+	}
+	u.c = c
+	u.which = UnionC
+	u.notNil = true
+	return nil
+}
@@ -0,0 +1,246 @@
+// SPDX-FileCopyrightText: 2024 Winni Neessen <wn@neessen.dev>
+//
+// SPDX-License-Identifier: MIT
+
+package niljson
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+)
+
+func TestUnion2_UnmarshalJSON_StringArm(t *testing.T) {
+	type JSONType struct {
+		ID NilStringOrInt `json:"id"`
+	}
+
+	var jt JSONType
+	if err := json.Unmarshal([]byte(`{"id":"foo"}`), &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	value, ok := jt.ID.A()
+	if !ok || value != "foo" {
+		t.Errorf("expected string arm to be %q, got %q (ok=%t)", "foo", value, ok)
+	}
+	if jt.ID.Which() != UnionA {
+		t.Errorf("expected Which() to be UnionA, got %d", jt.ID.Which())
+	}
+}
+
+func TestUnion2_UnmarshalJSON_IntArm(t *testing.T) {
+	type JSONType struct {
+		ID NilStringOrInt `json:"id"`
+	}
+
+	var jt JSONType
+	if err := json.Unmarshal([]byte(`{"id":42}`), &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	value, ok := jt.ID.B()
+	if !ok || value != 42 {
+		t.Errorf("expected int arm to be %d, got %d (ok=%t)", 42, value, ok)
+	}
+	if jt.ID.Which() != UnionB {
+		t.Errorf("expected Which() to be UnionB, got %d", jt.ID.Which())
+	}
+}
+
+func TestUnion2_UnmarshalJSON_Null(t *testing.T) {
+	type JSONType struct {
+		ID NilStringOrInt `json:"id"`
+	}
+
+	var jt JSONType
+	if err := json.Unmarshal([]byte(`{"id":null}`), &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	if jt.ID.NotNil() {
+		t.Errorf(ErrExpectedNil)
+	}
+	if jt.ID.Omitted() {
+		t.Error("expected ID to be present, not omitted")
+	}
+}
+
+func TestUnion2_UnmarshalJSON_Omitted(t *testing.T) {
+	type JSONType struct {
+		ID NilStringOrInt `json:"id,omitempty"`
+	}
+
+	var jt JSONType
+	if err := json.Unmarshal([]byte(`{}`), &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	if !jt.ID.Omitted() {
+		t.Error("expected ID to be omitted")
+	}
+}
+
+func TestUnion2_MarshalJSON(t *testing.T) {
+	type JSONType struct {
+		ID NilStringOrInt `json:"id"`
+	}
+
+	jt := &JSONType{}
+	jt.ID.SetB(42)
+	data, err := json.Marshal(jt)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+
+	expected := `{"id":42}`
+	if !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestUnion2_SetA(t *testing.T) {
+	var u NilStringOrInt
+	u.SetA("foo")
+
+	value, ok := u.A()
+	if !ok || value != "foo" {
+		t.Errorf("expected string arm to be %q, got %q (ok=%t)", "foo", value, ok)
+	}
+	if u.IsNil() {
+		t.Errorf(ErrExpectedValue)
+	}
+	if u.Omitted() {
+		t.Error("expected Union2 to be present after SetA")
+	}
+	if u.Which() != UnionA {
+		t.Errorf("expected Which() to be UnionA, got %d", u.Which())
+	}
+}
+
+func TestUnion2_Reset(t *testing.T) {
+	var u NilStringOrInt
+	u.SetA("foo")
+	u.Reset()
+
+	if u.NotNil() {
+		t.Errorf(ErrExpectedNilReset)
+	}
+	if u.Which() != UnionNone {
+		t.Errorf("expected Which() to be UnionNone after Reset, got %d", u.Which())
+	}
+	if _, ok := u.A(); ok {
+		t.Error("expected A() to report false after Reset")
+	}
+}
+
+func TestUnion3_UnmarshalJSON(t *testing.T) {
+	type JSONType struct {
+		Value Union3[string, int64, bool] `json:"value"`
+	}
+
+	var jt JSONType
+	if err := json.Unmarshal([]byte(`{"value":true}`), &jt); err != nil {
+		t.Errorf(ErrUnmarshalFailed, err)
+	}
+	value, ok := jt.Value.C()
+	if !ok || !value {
+		t.Errorf("expected bool arm to be true, got %t (ok=%t)", value, ok)
+	}
+	if jt.Value.Which() != UnionC {
+		t.Errorf("expected Which() to be UnionC, got %d", jt.Value.Which())
+	}
+}
+
+func TestUnion3_Reset(t *testing.T) {
+	var u Union3[string, int64, bool]
+	u.SetA("foo")
+	u.Reset()
+	if u.NotNil() {
+		t.Errorf(ErrExpectedNilReset)
+	}
+	if u.Which() != UnionNone {
+		t.Errorf("expected Which() to be UnionNone after Reset, got %d", u.Which())
+	}
+}
+
+func TestUnion3_MarshalJSON_AllArms(t *testing.T) {
+	var a Union3[string, int64, bool]
+	a.SetA("foo")
+	data, err := json.Marshal(&a)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+	if expected := `"foo"`; !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+
+	var b Union3[string, int64, bool]
+	b.SetB(42)
+	data, err = json.Marshal(&b)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+	if expected := `42`; !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+
+	var c Union3[string, int64, bool]
+	c.SetC(true)
+	data, err = json.Marshal(&c)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+	if expected := `true`; !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+
+	var none Union3[string, int64, bool]
+	data, err = json.Marshal(&none)
+	if err != nil {
+		t.Errorf(ErrMarshalFailed, err)
+	}
+	if expected := `null`; !bytes.Equal(data, []byte(expected)) {
+		t.Errorf(ErrExpectedJSONString, expected, string(data))
+	}
+}
+
+func TestUnion3_SetB_SetC(t *testing.T) {
+	var u Union3[string, int64, bool]
+	u.SetB(42)
+	value, ok := u.B()
+	if !ok || value != 42 {
+		t.Errorf("expected int arm to be %d, got %d (ok=%t)", 42, value, ok)
+	}
+	if u.Which() != UnionB {
+		t.Errorf("expected Which() to be UnionB, got %d", u.Which())
+	}
+
+	u.SetC(true)
+	boolValue, ok := u.C()
+	if !ok || !boolValue {
+		t.Errorf("expected bool arm to be true, got %t (ok=%t)", boolValue, ok)
+	}
+	if u.Which() != UnionC {
+		t.Errorf("expected Which() to be UnionC, got %d", u.Which())
+	}
+	// setting a later arm invalidates the earlier one
+	if _, ok := u.B(); ok {
+		t.Error("expected B() to report false once C is set")
+	}
+}
+
+func TestUnion3_A_B_Omitted(t *testing.T) {
+	var u Union3[string, int64, bool]
+	if !u.Omitted() {
+		t.Error("expected a zero-value Union3 to be omitted")
+	}
+
+	u.SetA("foo")
+	if u.Omitted() {
+		t.Error("expected Union3 to be present after SetA")
+	}
+	value, ok := u.A()
+	if !ok || value != "foo" {
+		t.Errorf("expected string arm to be %q, got %q (ok=%t)", "foo", value, ok)
+	}
+	if _, ok := u.B(); ok {
+		t.Error("expected B() to report false when the A arm is set")
+	}
+}